@@ -0,0 +1,47 @@
+// Command example-app is the reference application for the Pet Projects
+// Droplet Stack. Register additional handlers, middleware, or background
+// workers by adding their fx.Module to the fx.New call below.
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/superstas/pet-projects-droplet-stack/internal/buildinfo"
+	"github.com/superstas/pet-projects-droplet-stack/internal/config"
+	"github.com/superstas/pet-projects-droplet-stack/internal/handlers"
+	"github.com/superstas/pet-projects-droplet-stack/internal/healthcheck"
+	"github.com/superstas/pet-projects-droplet-stack/internal/logger"
+	"github.com/superstas/pet-projects-droplet-stack/internal/metrics"
+	"github.com/superstas/pet-projects-droplet-stack/internal/middleware"
+	"github.com/superstas/pet-projects-droplet-stack/internal/server"
+)
+
+// stopTimeoutMargin gives the app a little headroom over cfg.HTTP.ShutdownTimeout
+// so fx's own stop deadline never cuts the configured drain short.
+const stopTimeoutMargin = 5 * time.Second
+
+func main() {
+	// Config is resolved before the fx.App exists: its shutdown timeout has
+	// to feed fx.StopTimeout, which bounds every OnStop hook's context.
+	cfg, err := config.New()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	fx.New(
+		fx.Supply(cfg),
+		fx.StopTimeout(cfg.HTTP.ShutdownTimeout+stopTimeoutMargin),
+		buildinfo.Module,
+		logger.Module,
+		metrics.Module,
+		middleware.Module,
+		handlers.Module,
+		healthcheck.Module,
+		server.Module,
+	).Run()
+}
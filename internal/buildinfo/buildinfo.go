@@ -0,0 +1,21 @@
+// Package buildinfo exposes the version and commit a binary was built from.
+package buildinfo
+
+// Version and Commit are overridden at build time via:
+//
+//	go build -ldflags "-X .../internal/buildinfo.Version=... -X .../internal/buildinfo.Commit=..."
+var (
+	Version = "dev"
+	Commit  = "unknown"
+)
+
+// Info is the build metadata surfaced on the index page.
+type Info struct {
+	Version string
+	Commit  string
+}
+
+// New returns the build Info captured from the package-level vars.
+func New() Info {
+	return Info{Version: Version, Commit: Commit}
+}
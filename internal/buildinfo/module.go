@@ -0,0 +1,8 @@
+package buildinfo
+
+import "go.uber.org/fx"
+
+// Module wires the buildinfo package into the fx application graph.
+var Module = fx.Module("buildinfo",
+	fx.Provide(New),
+)
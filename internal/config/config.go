@@ -0,0 +1,80 @@
+// Package config resolves the application's runtime configuration in order
+// of precedence: CLI flags > environment variables > config file > defaults.
+package config
+
+import (
+	"flag"
+	"fmt"
+	"time"
+)
+
+// Config holds the settings needed to bootstrap the application.
+type Config struct {
+	HTTP    HTTPConfig
+	Log     LogConfig
+	Metrics MetricsConfig
+	TLS     TLSConfig
+}
+
+// HTTPConfig controls the HTTP server's listener and timeouts.
+type HTTPConfig struct {
+	Host            string        `toml:"host" yaml:"host"`
+	Port            int           `toml:"port" yaml:"port"`
+	ReadTimeout     time.Duration `toml:"read_timeout" yaml:"read_timeout"`
+	WriteTimeout    time.Duration `toml:"write_timeout" yaml:"write_timeout"`
+	IdleTimeout     time.Duration `toml:"idle_timeout" yaml:"idle_timeout"`
+	ShutdownTimeout time.Duration `toml:"shutdown_timeout" yaml:"shutdown_timeout"`
+}
+
+// LogConfig controls the structured logger.
+type LogConfig struct {
+	Level  string `toml:"level" yaml:"level"`
+	Format string `toml:"format" yaml:"format"`
+}
+
+// MetricsConfig controls the /metrics endpoint.
+type MetricsConfig struct {
+	Enabled bool   `toml:"enabled" yaml:"enabled"`
+	Path    string `toml:"path" yaml:"path"`
+}
+
+// TLSConfig controls optional TLS termination.
+type TLSConfig struct {
+	CertFile string `toml:"cert_file" yaml:"cert_file"`
+	KeyFile  string `toml:"key_file" yaml:"key_file"`
+	AutoCert string `toml:"autocert_domain" yaml:"autocert_domain"`
+}
+
+// New resolves the Config from flags, environment variables, an optional
+// config file, and defaults, then validates the result.
+func New() (*Config, error) {
+	cfg := defaults()
+
+	fv := registerFlags()
+	flag.Parse()
+
+	if *fv.configPath != "" {
+		if err := loadFile(*fv.configPath, &cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := applyEnv(&cfg); err != nil {
+		return nil, err
+	}
+
+	if err := applyFlags(&cfg, fv); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	return &cfg, nil
+}
+
+// Addr returns the host:port address the HTTP server should listen on.
+func (c *Config) Addr() string {
+	return fmt.Sprintf("%s:%d", c.HTTP.Host, c.HTTP.Port)
+}
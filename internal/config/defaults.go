@@ -0,0 +1,25 @@
+package config
+
+import "time"
+
+// defaults returns the Config applied before file, env, or flag overrides.
+func defaults() Config {
+	return Config{
+		HTTP: HTTPConfig{
+			Host:            "0.0.0.0",
+			Port:            9000,
+			ReadTimeout:     15 * time.Second,
+			WriteTimeout:    15 * time.Second,
+			IdleTimeout:     60 * time.Second,
+			ShutdownTimeout: 30 * time.Second,
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: "text",
+		},
+		Metrics: MetricsConfig{
+			Enabled: true,
+			Path:    "/metrics",
+		},
+	}
+}
@@ -0,0 +1,80 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// applyEnv overlays APP_-prefixed environment variables onto cfg, e.g.
+// APP_HTTP_PORT or APP_LOG_FORMAT.
+func applyEnv(cfg *Config) error {
+	if v, ok := os.LookupEnv("APP_HTTP_HOST"); ok {
+		cfg.HTTP.Host = v
+	}
+	if v, ok := os.LookupEnv("APP_HTTP_PORT"); ok {
+		port, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse APP_HTTP_PORT: %w", err)
+		}
+		cfg.HTTP.Port = port
+	}
+	if err := applyEnvDuration("APP_HTTP_READ_TIMEOUT", &cfg.HTTP.ReadTimeout); err != nil {
+		return err
+	}
+	if err := applyEnvDuration("APP_HTTP_WRITE_TIMEOUT", &cfg.HTTP.WriteTimeout); err != nil {
+		return err
+	}
+	if err := applyEnvDuration("APP_HTTP_IDLE_TIMEOUT", &cfg.HTTP.IdleTimeout); err != nil {
+		return err
+	}
+	if err := applyEnvDuration("APP_HTTP_SHUTDOWN_TIMEOUT", &cfg.HTTP.ShutdownTimeout); err != nil {
+		return err
+	}
+
+	if v, ok := os.LookupEnv("APP_LOG_LEVEL"); ok {
+		cfg.Log.Level = v
+	}
+	if v, ok := os.LookupEnv("APP_LOG_FORMAT"); ok {
+		cfg.Log.Format = v
+	}
+
+	if v, ok := os.LookupEnv("APP_METRICS_ENABLED"); ok {
+		enabled, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("parse APP_METRICS_ENABLED: %w", err)
+		}
+		cfg.Metrics.Enabled = enabled
+	}
+	if v, ok := os.LookupEnv("APP_METRICS_PATH"); ok {
+		cfg.Metrics.Path = v
+	}
+
+	if v, ok := os.LookupEnv("APP_TLS_CERT_FILE"); ok {
+		cfg.TLS.CertFile = v
+	}
+	if v, ok := os.LookupEnv("APP_TLS_KEY_FILE"); ok {
+		cfg.TLS.KeyFile = v
+	}
+	if v, ok := os.LookupEnv("APP_TLS_AUTOCERT_DOMAIN"); ok {
+		cfg.TLS.AutoCert = v
+	}
+
+	return nil
+}
+
+func applyEnvDuration(name string, dst *time.Duration) error {
+	v, ok := os.LookupEnv(name)
+	if !ok {
+		return nil
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return fmt.Errorf("parse %s: %w", name, err)
+	}
+
+	*dst = d
+	return nil
+}
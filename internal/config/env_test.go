@@ -0,0 +1,78 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestApplyEnv_Overlay(t *testing.T) {
+	t.Setenv("APP_HTTP_HOST", "127.0.0.1")
+	t.Setenv("APP_HTTP_PORT", "8080")
+	t.Setenv("APP_HTTP_SHUTDOWN_TIMEOUT", "45s")
+	t.Setenv("APP_LOG_FORMAT", "json")
+	t.Setenv("APP_METRICS_ENABLED", "false")
+	t.Setenv("APP_TLS_CERT_FILE", "cert.pem")
+
+	cfg := defaults()
+	if err := applyEnv(&cfg); err != nil {
+		t.Fatalf("applyEnv() = %v, want nil", err)
+	}
+
+	if cfg.HTTP.Host != "127.0.0.1" {
+		t.Errorf("HTTP.Host = %q, want %q", cfg.HTTP.Host, "127.0.0.1")
+	}
+	if cfg.HTTP.Port != 8080 {
+		t.Errorf("HTTP.Port = %d, want %d", cfg.HTTP.Port, 8080)
+	}
+	if cfg.HTTP.ShutdownTimeout != 45*time.Second {
+		t.Errorf("HTTP.ShutdownTimeout = %s, want %s", cfg.HTTP.ShutdownTimeout, 45*time.Second)
+	}
+	if cfg.Log.Format != "json" {
+		t.Errorf("Log.Format = %q, want %q", cfg.Log.Format, "json")
+	}
+	if cfg.Metrics.Enabled {
+		t.Errorf("Metrics.Enabled = true, want false")
+	}
+	if cfg.TLS.CertFile != "cert.pem" {
+		t.Errorf("TLS.CertFile = %q, want %q", cfg.TLS.CertFile, "cert.pem")
+	}
+}
+
+func TestApplyEnv_LeavesUnsetFieldsAtDefault(t *testing.T) {
+	cfg := defaults()
+	want := cfg
+
+	if err := applyEnv(&cfg); err != nil {
+		t.Fatalf("applyEnv() = %v, want nil", err)
+	}
+	if cfg != want {
+		t.Fatalf("applyEnv() changed cfg with no env vars set: got %+v, want %+v", cfg, want)
+	}
+}
+
+func TestApplyEnv_InvalidPort(t *testing.T) {
+	t.Setenv("APP_HTTP_PORT", "not-a-number")
+
+	cfg := defaults()
+	if err := applyEnv(&cfg); err == nil {
+		t.Fatal("applyEnv() = nil, want error for invalid APP_HTTP_PORT")
+	}
+}
+
+func TestApplyEnv_InvalidDuration(t *testing.T) {
+	t.Setenv("APP_HTTP_READ_TIMEOUT", "not-a-duration")
+
+	cfg := defaults()
+	if err := applyEnv(&cfg); err == nil {
+		t.Fatal("applyEnv() = nil, want error for invalid APP_HTTP_READ_TIMEOUT")
+	}
+}
+
+func TestApplyEnv_InvalidBool(t *testing.T) {
+	t.Setenv("APP_METRICS_ENABLED", "not-a-bool")
+
+	cfg := defaults()
+	if err := applyEnv(&cfg); err == nil {
+		t.Fatal("applyEnv() = nil, want error for invalid APP_METRICS_ENABLED")
+	}
+}
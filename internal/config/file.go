@@ -0,0 +1,34 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// loadFile decodes a TOML or YAML config file (chosen by extension) onto
+// cfg, overriding whichever defaults it sets fields for.
+func loadFile(path string, cfg *Config) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config file: %w", err)
+	}
+
+	switch ext := filepath.Ext(path); ext {
+	case ".toml":
+		if _, err := toml.Decode(string(data), cfg); err != nil {
+			return fmt.Errorf("decode toml config: %w", err)
+		}
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, cfg); err != nil {
+			return fmt.Errorf("decode yaml config: %w", err)
+		}
+	default:
+		return fmt.Errorf("unsupported config file extension %q (want .toml, .yaml, or .yml)", ext)
+	}
+
+	return nil
+}
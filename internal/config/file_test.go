@@ -0,0 +1,89 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadFile_TOML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	writeFile(t, path, `
+[http]
+host = "10.0.0.1"
+port = 9090
+
+[log]
+format = "json"
+`)
+
+	cfg := defaults()
+	if err := loadFile(path, &cfg); err != nil {
+		t.Fatalf("loadFile() = %v, want nil", err)
+	}
+
+	if cfg.HTTP.Host != "10.0.0.1" {
+		t.Errorf("HTTP.Host = %q, want %q", cfg.HTTP.Host, "10.0.0.1")
+	}
+	if cfg.HTTP.Port != 9090 {
+		t.Errorf("HTTP.Port = %d, want %d", cfg.HTTP.Port, 9090)
+	}
+	if cfg.Log.Format != "json" {
+		t.Errorf("Log.Format = %q, want %q", cfg.Log.Format, "json")
+	}
+	// Fields the file doesn't mention keep their defaults.
+	if cfg.HTTP.ReadTimeout != 15*time.Second {
+		t.Errorf("HTTP.ReadTimeout = %s, want default %s", cfg.HTTP.ReadTimeout, 15*time.Second)
+	}
+}
+
+func TestLoadFile_YAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeFile(t, path, `
+http:
+  host: "10.0.0.2"
+  port: 9091
+log:
+  format: json
+`)
+
+	cfg := defaults()
+	if err := loadFile(path, &cfg); err != nil {
+		t.Fatalf("loadFile() = %v, want nil", err)
+	}
+
+	if cfg.HTTP.Host != "10.0.0.2" {
+		t.Errorf("HTTP.Host = %q, want %q", cfg.HTTP.Host, "10.0.0.2")
+	}
+	if cfg.HTTP.Port != 9091 {
+		t.Errorf("HTTP.Port = %d, want %d", cfg.HTTP.Port, 9091)
+	}
+	if cfg.Log.Format != "json" {
+		t.Errorf("Log.Format = %q, want %q", cfg.Log.Format, "json")
+	}
+}
+
+func TestLoadFile_UnsupportedExtension(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.ini")
+	writeFile(t, path, "host=10.0.0.3")
+
+	cfg := defaults()
+	if err := loadFile(path, &cfg); err == nil {
+		t.Fatal("loadFile() = nil, want error for unsupported extension")
+	}
+}
+
+func TestLoadFile_MissingFile(t *testing.T) {
+	cfg := defaults()
+	if err := loadFile(filepath.Join(t.TempDir(), "missing.toml"), &cfg); err == nil {
+		t.Fatal("loadFile() = nil, want error for missing file")
+	}
+}
+
+func writeFile(t *testing.T, path, contents string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("write test config file: %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"time"
+)
+
+// flagValues holds the destinations flag.Parse writes into. Each flag
+// defaults to its zero value rather than the application default, so
+// flagSet(name) lets applyFlags tell "explicitly set by the user" apart from
+// "left at the flag package's default".
+type flagValues struct {
+	configPath      *string
+	host            *string
+	port            *int
+	readTimeout     *string
+	writeTimeout    *string
+	idleTimeout     *string
+	shutdownTimeout *string
+	logLevel        *string
+	logFormat       *string
+	metricsEnabled  *bool
+	metricsPath     *string
+	tlsCertFile     *string
+	tlsKeyFile      *string
+	autocertDomain  *string
+}
+
+// registerFlags registers every supported flag and returns their destinations.
+func registerFlags() *flagValues {
+	return &flagValues{
+		configPath:      flag.String("config", "", "Path to a TOML or YAML config file"),
+		host:            flag.String("host", "", "Host to bind to"),
+		port:            flag.Int("port", 0, "Port to listen on"),
+		readTimeout:     flag.String("read-timeout", "", "HTTP read timeout"),
+		writeTimeout:    flag.String("write-timeout", "", "HTTP write timeout"),
+		idleTimeout:     flag.String("idle-timeout", "", "HTTP idle timeout"),
+		shutdownTimeout: flag.String("shutdown-timeout", "", "Grace period to drain in-flight requests before forcing shutdown"),
+		logLevel:        flag.String("log-level", "", "Log level: debug, info, warn, error"),
+		logFormat:       flag.String("log-format", "", "Log output format: text or json"),
+		metricsEnabled:  flag.Bool("metrics-enabled", false, "Enable the /metrics endpoint"),
+		metricsPath:     flag.String("metrics-path", "", "Path the /metrics endpoint is served on"),
+		tlsCertFile:     flag.String("tls-cert", "", "TLS certificate file"),
+		tlsKeyFile:      flag.String("tls-key", "", "TLS key file"),
+		autocertDomain:  flag.String("autocert-domain", "", "Domain to obtain a Let's Encrypt certificate for via autocert"),
+	}
+}
+
+// applyFlags overlays flags the user explicitly passed onto cfg. Flags left
+// untouched keep whatever file/env/default layer already set.
+func applyFlags(cfg *Config, fv *flagValues) error {
+	var err error
+
+	flag.Visit(func(f *flag.Flag) {
+		if err != nil {
+			return
+		}
+
+		switch f.Name {
+		case "host":
+			cfg.HTTP.Host = *fv.host
+		case "port":
+			cfg.HTTP.Port = *fv.port
+		case "read-timeout":
+			cfg.HTTP.ReadTimeout, err = parseDurationFlag(f.Name, *fv.readTimeout)
+		case "write-timeout":
+			cfg.HTTP.WriteTimeout, err = parseDurationFlag(f.Name, *fv.writeTimeout)
+		case "idle-timeout":
+			cfg.HTTP.IdleTimeout, err = parseDurationFlag(f.Name, *fv.idleTimeout)
+		case "shutdown-timeout":
+			cfg.HTTP.ShutdownTimeout, err = parseDurationFlag(f.Name, *fv.shutdownTimeout)
+		case "log-level":
+			cfg.Log.Level = *fv.logLevel
+		case "log-format":
+			cfg.Log.Format = *fv.logFormat
+		case "metrics-enabled":
+			cfg.Metrics.Enabled = *fv.metricsEnabled
+		case "metrics-path":
+			cfg.Metrics.Path = *fv.metricsPath
+		case "tls-cert":
+			cfg.TLS.CertFile = *fv.tlsCertFile
+		case "tls-key":
+			cfg.TLS.KeyFile = *fv.tlsKeyFile
+		case "autocert-domain":
+			cfg.TLS.AutoCert = *fv.autocertDomain
+		}
+	})
+
+	return err
+}
+
+func parseDurationFlag(name, value string) (time.Duration, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("parse -%s: %w", name, err)
+	}
+	return d, nil
+}
@@ -0,0 +1,34 @@
+package config
+
+import "fmt"
+
+// Validate checks that the resolved Config is internally consistent.
+func (c *Config) Validate() error {
+	if c.HTTP.Port < 1 || c.HTTP.Port > 65535 {
+		return fmt.Errorf("http.port must be between 1 and 65535, got %d", c.HTTP.Port)
+	}
+
+	if c.HTTP.ReadTimeout <= 0 || c.HTTP.WriteTimeout <= 0 || c.HTTP.IdleTimeout <= 0 || c.HTTP.ShutdownTimeout <= 0 {
+		return fmt.Errorf("http timeouts must be positive")
+	}
+
+	if c.HTTP.IdleTimeout < c.HTTP.ReadTimeout {
+		return fmt.Errorf("http.idle_timeout (%s) must be >= http.read_timeout (%s)", c.HTTP.IdleTimeout, c.HTTP.ReadTimeout)
+	}
+
+	switch c.Log.Format {
+	case "text", "json":
+	default:
+		return fmt.Errorf("log.format must be text or json, got %q", c.Log.Format)
+	}
+
+	if (c.TLS.CertFile != "") != (c.TLS.KeyFile != "") {
+		return fmt.Errorf("tls.cert_file and tls.key_file must be set together")
+	}
+
+	if c.TLS.AutoCert != "" && c.TLS.CertFile != "" {
+		return fmt.Errorf("tls.autocert_domain and tls.cert_file are mutually exclusive")
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func validConfig() Config {
+	cfg := defaults()
+	cfg.HTTP.Host = "0.0.0.0"
+	return cfg
+}
+
+func TestValidate_Valid(t *testing.T) {
+	cfg := validConfig()
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() = %v, want nil", err)
+	}
+}
+
+func TestValidate_Port(t *testing.T) {
+	tests := []struct {
+		name    string
+		port    int
+		wantErr bool
+	}{
+		{"min valid", 1, false},
+		{"max valid", 65535, false},
+		{"zero", 0, true},
+		{"negative", -1, true},
+		{"too large", 65536, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.HTTP.Port = tt.port
+
+			err := cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidate_Timeouts(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(*Config)
+		wantErr bool
+	}{
+		{"zero read timeout", func(c *Config) { c.HTTP.ReadTimeout = 0 }, true},
+		{"zero write timeout", func(c *Config) { c.HTTP.WriteTimeout = 0 }, true},
+		{"zero idle timeout", func(c *Config) { c.HTTP.IdleTimeout = 0 }, true},
+		{"zero shutdown timeout", func(c *Config) { c.HTTP.ShutdownTimeout = 0 }, true},
+		{"negative read timeout", func(c *Config) { c.HTTP.ReadTimeout = -time.Second }, true},
+		{"idle below read", func(c *Config) {
+			c.HTTP.ReadTimeout = 10 * time.Second
+			c.HTTP.IdleTimeout = 5 * time.Second
+		}, true},
+		{"idle equal to read", func(c *Config) {
+			c.HTTP.ReadTimeout = 10 * time.Second
+			c.HTTP.IdleTimeout = 10 * time.Second
+		}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			tt.mutate(&cfg)
+
+			err := cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidate_LogFormat(t *testing.T) {
+	tests := []struct {
+		format  string
+		wantErr bool
+	}{
+		{"text", false},
+		{"json", false},
+		{"xml", true},
+		{"", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.Log.Format = tt.format
+
+			err := cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidate_TLS(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(*Config)
+		wantErr bool
+	}{
+		{"no tls", func(c *Config) {}, false},
+		{"cert and key set", func(c *Config) {
+			c.TLS.CertFile = "cert.pem"
+			c.TLS.KeyFile = "key.pem"
+		}, false},
+		{"cert without key", func(c *Config) { c.TLS.CertFile = "cert.pem" }, true},
+		{"key without cert", func(c *Config) { c.TLS.KeyFile = "key.pem" }, true},
+		{"autocert alone", func(c *Config) { c.TLS.AutoCert = "example.com" }, false},
+		{"autocert and cert file", func(c *Config) {
+			c.TLS.AutoCert = "example.com"
+			c.TLS.CertFile = "cert.pem"
+			c.TLS.KeyFile = "key.pem"
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			tt.mutate(&cfg)
+
+			err := cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
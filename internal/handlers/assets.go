@@ -0,0 +1,21 @@
+// Package handlers implements the application's user-facing HTTP handlers.
+package handlers
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed templates/* static/*
+var assets embed.FS
+
+// mustSubFS returns the subtree of fsys rooted at dir, panicking if dir
+// doesn't exist. Used for embed.FS subtrees whose presence is guaranteed at
+// compile time by the go:embed directive above.
+func mustSubFS(fsys embed.FS, dir string) fs.FS {
+	sub, err := fs.Sub(fsys, dir)
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
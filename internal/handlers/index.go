@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"html/template"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/superstas/pet-projects-droplet-stack/internal/buildinfo"
+)
+
+var indexTmpl = template.Must(template.ParseFS(assets, "templates/index.html.tmpl"))
+
+var startTime = time.Now()
+
+// indexData is the data rendered into templates/index.html.tmpl.
+type indexData struct {
+	Version  string
+	Commit   string
+	Uptime   string
+	Hostname string
+}
+
+// Index serves the main landing page, templated with the running
+// deployment's build info, uptime, and hostname.
+func Index(build buildinfo.Info) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+
+		hostname, err := os.Hostname()
+		if err != nil {
+			hostname = "unknown"
+		}
+
+		data := indexData{
+			Version:  build.Version,
+			Commit:   build.Commit,
+			Uptime:   time.Since(startTime).Round(time.Second).String(),
+			Hostname: hostname,
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := indexTmpl.Execute(w, data); err != nil {
+			http.Error(w, "failed to render page", http.StatusInternalServerError)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"net/http"
+
+	"go.uber.org/fx"
+
+	"github.com/superstas/pet-projects-droplet-stack/internal/buildinfo"
+)
+
+// Module wires the handlers package into the fx application graph.
+var Module = fx.Module("handlers",
+	fx.Invoke(registerRoutes),
+)
+
+// registerRoutes attaches the package's handlers to mux.
+func registerRoutes(mux *http.ServeMux, build buildinfo.Info) {
+	mux.Handle("/", Index(build))
+	mux.Handle("/static/", http.StripPrefix("/static/", staticHandler()))
+}
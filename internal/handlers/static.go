@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"net/http"
+	"strings"
+)
+
+var staticFS = mustSubFS(assets, "static")
+
+// staticETags maps each embedded static/ file path to a content-hash ETag,
+// computed once at init time so requests never re-read or re-hash the file.
+var staticETags = mustStaticETags(staticFS)
+
+// mustStaticETags walks fsys and returns a path -> ETag map, panicking on
+// error since fsys is an embed.FS whose contents are fixed at compile time.
+func mustStaticETags(fsys fs.FS) map[string]string {
+	etags := make(map[string]string)
+
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		data, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return err
+		}
+
+		sum := sha256.Sum256(data)
+		etags[path] = `"` + hex.EncodeToString(sum[:8]) + `"`
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return etags
+}
+
+// staticHandler serves the embedded static/ tree, setting Cache-Control and
+// a content-hash ETag so clients can cache assets safely. It expects to be
+// mounted behind an http.StripPrefix so r.URL.Path is relative to static/.
+func staticHandler() http.Handler {
+	fileServer := http.FileServerFS(staticFS)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if etag, ok := staticETags[strings.TrimPrefix(r.URL.Path, "/")]; ok {
+			w.Header().Set("ETag", etag)
+		}
+
+		w.Header().Set("Cache-Control", "public, max-age=3600")
+		fileServer.ServeHTTP(w, r)
+	})
+}
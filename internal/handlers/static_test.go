@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"io/fs"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStaticETags_CoverEveryFile(t *testing.T) {
+	err := fs.WalkDir(staticFS, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		if _, ok := staticETags[path]; !ok {
+			t.Errorf("staticETags missing entry for %q", path)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("walk staticFS: %v", err)
+	}
+}
+
+func TestStaticHandler_SetsPrecomputedETag(t *testing.T) {
+	handler := staticHandler()
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/style.css", nil)
+	handler.ServeHTTP(rec, req)
+
+	want := staticETags["style.css"]
+	if got := rec.Header().Get("ETag"); got != want {
+		t.Errorf("ETag = %q, want %q", got, want)
+	}
+	if cc := rec.Header().Get("Cache-Control"); cc != "public, max-age=3600" {
+		t.Errorf("Cache-Control = %q, want %q", cc, "public, max-age=3600")
+	}
+}
+
+func TestStaticHandler_UnknownPathNoETag(t *testing.T) {
+	handler := staticHandler()
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/does-not-exist.css", nil)
+	handler.ServeHTTP(rec, req)
+
+	if got := rec.Header().Get("ETag"); got != "" {
+		t.Errorf("ETag = %q, want empty for unknown path", got)
+	}
+}
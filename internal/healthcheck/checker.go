@@ -0,0 +1,9 @@
+package healthcheck
+
+import "context"
+
+// Checker is implemented by anything that can report its own health.
+type Checker interface {
+	Name() string
+	Check(ctx context.Context) error
+}
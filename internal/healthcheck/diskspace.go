@@ -0,0 +1,38 @@
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"syscall"
+)
+
+// DiskSpaceChecker reports unhealthy once free space on Path drops below
+// MinFreeBytes.
+type DiskSpaceChecker struct {
+	Path         string
+	MinFreeBytes uint64
+}
+
+// NewDiskSpaceChecker creates a DiskSpaceChecker that fails once free space
+// on path drops below minFreeBytes.
+func NewDiskSpaceChecker(path string, minFreeBytes uint64) *DiskSpaceChecker {
+	return &DiskSpaceChecker{Path: path, MinFreeBytes: minFreeBytes}
+}
+
+// Name implements Checker.
+func (c *DiskSpaceChecker) Name() string { return "disk_space" }
+
+// Check implements Checker.
+func (c *DiskSpaceChecker) Check(ctx context.Context) error {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(c.Path, &stat); err != nil {
+		return fmt.Errorf("statfs %s: %w", c.Path, err)
+	}
+
+	free := stat.Bavail * uint64(stat.Bsize)
+	if free < c.MinFreeBytes {
+		return fmt.Errorf("only %d bytes free on %s, want at least %d", free, c.Path, c.MinFreeBytes)
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+)
+
+// GoroutineChecker reports unhealthy once the number of live goroutines
+// exceeds Max, a signal of a goroutine leak.
+type GoroutineChecker struct {
+	Max int
+}
+
+// NewGoroutineChecker creates a GoroutineChecker that fails once the live
+// goroutine count exceeds max.
+func NewGoroutineChecker(max int) *GoroutineChecker {
+	return &GoroutineChecker{Max: max}
+}
+
+// Name implements Checker.
+func (c *GoroutineChecker) Name() string { return "goroutines" }
+
+// Check implements Checker.
+func (c *GoroutineChecker) Check(ctx context.Context) error {
+	n := runtime.NumGoroutine()
+	if n > c.Max {
+		return fmt.Errorf("%d goroutines running, want at most %d", n, c.Max)
+	}
+	return nil
+}
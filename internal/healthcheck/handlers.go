@@ -0,0 +1,58 @@
+package healthcheck
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+)
+
+// Healthcheck serves the application's liveness and readiness endpoints.
+type Healthcheck struct {
+	registry *Registry
+	ready    atomic.Bool
+}
+
+// New creates a Healthcheck with an empty check registry. The process starts
+// out ready; call SetNotReady during shutdown so load balancers stop routing
+// to it before the server finishes draining.
+func New() *Healthcheck {
+	h := &Healthcheck{registry: NewRegistry()}
+	h.ready.Store(true)
+	return h
+}
+
+// Register adds a Checker that Readyz will run.
+func (h *Healthcheck) Register(c Checker) {
+	h.registry.Register(c)
+}
+
+// SetNotReady marks the process as not ready to receive traffic.
+func (h *Healthcheck) SetNotReady() {
+	h.ready.Store(false)
+}
+
+// Livez reports whether the process is alive. It never runs checks, so it
+// stays cheap even when downstream dependencies are unhealthy.
+func (h *Healthcheck) Livez(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(w, `{"status":"ok"}`)
+}
+
+// Readyz runs every registered Checker and reports whether the process
+// should receive traffic.
+func (h *Healthcheck) Readyz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if !h.ready.Load() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		json.NewEncoder(w).Encode(Result{Status: "shutting_down", Checks: map[string]CheckResult{}})
+		return
+	}
+
+	result := h.registry.Run(r.Context())
+	if result.Status != "ok" {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(result)
+}
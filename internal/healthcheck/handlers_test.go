@@ -0,0 +1,73 @@
+package healthcheck
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHealthcheck_Livez(t *testing.T) {
+	h := New()
+	h.Register(fakeChecker{name: "down", err: errors.New("boom")})
+
+	rec := httptest.NewRecorder()
+	h.Livez(rec, httptest.NewRequest(http.MethodGet, "/livez", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestHealthcheck_Readyz_OK(t *testing.T) {
+	h := New()
+	h.Register(fakeChecker{name: "a"})
+
+	rec := httptest.NewRecorder()
+	h.Readyz(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var result Result
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	if result.Status != "ok" {
+		t.Fatalf("Status = %q, want %q", result.Status, "ok")
+	}
+}
+
+func TestHealthcheck_Readyz_CheckFails(t *testing.T) {
+	h := New()
+	h.Register(fakeChecker{name: "a", err: errors.New("boom")})
+
+	rec := httptest.NewRecorder()
+	h.Readyz(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+}
+
+func TestHealthcheck_Readyz_NotReady(t *testing.T) {
+	h := New()
+	h.SetNotReady()
+
+	rec := httptest.NewRecorder()
+	h.Readyz(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+
+	var result Result
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	if result.Status != "shutting_down" {
+		t.Fatalf("Status = %q, want %q", result.Status, "shutting_down")
+	}
+}
@@ -0,0 +1,30 @@
+package healthcheck
+
+import (
+	"net/http"
+
+	"go.uber.org/fx"
+)
+
+const (
+	defaultMinFreeBytes  = 100 * 1024 * 1024 // 100MB
+	defaultMaxGoroutines = 10000
+)
+
+// Module wires the healthcheck package into the fx application graph.
+var Module = fx.Module("healthcheck",
+	fx.Provide(New),
+	fx.Invoke(registerChecks, registerRoutes),
+)
+
+// registerChecks registers the built-in example checks.
+func registerChecks(h *Healthcheck) {
+	h.Register(NewDiskSpaceChecker("/", defaultMinFreeBytes))
+	h.Register(NewGoroutineChecker(defaultMaxGoroutines))
+}
+
+// registerRoutes attaches the liveness and readiness endpoints to mux.
+func registerRoutes(mux *http.ServeMux, h *Healthcheck) {
+	mux.HandleFunc("/livez", h.Livez)
+	mux.HandleFunc("/readyz", h.Readyz)
+}
@@ -0,0 +1,88 @@
+package healthcheck
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// CheckTimeout bounds how long a single Checker is allowed to run before it
+// is considered failed.
+const CheckTimeout = 2 * time.Second
+
+// CheckResult is the outcome of running a single Checker.
+type CheckResult struct {
+	OK        bool   `json:"ok"`
+	Error     string `json:"error,omitempty"`
+	LatencyMS int64  `json:"latency_ms"`
+}
+
+// Result is the aggregated outcome of running every registered Checker.
+type Result struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+// Registry runs a set of Checkers concurrently and aggregates their results.
+type Registry struct {
+	mu       sync.RWMutex
+	checkers []Checker
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register adds a Checker to the registry.
+func (r *Registry) Register(c Checker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checkers = append(r.checkers, c)
+}
+
+// Run executes every registered Checker in parallel, each bounded by
+// CheckTimeout, and returns the aggregated Result.
+func (r *Registry) Run(ctx context.Context) Result {
+	r.mu.RLock()
+	checkers := make([]Checker, len(r.checkers))
+	copy(checkers, r.checkers)
+	r.mu.RUnlock()
+
+	checks := make(map[string]CheckResult, len(checkers))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, c := range checkers {
+		wg.Add(1)
+		go func(c Checker) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, CheckTimeout)
+			defer cancel()
+
+			start := time.Now()
+			err := c.Check(checkCtx)
+			result := CheckResult{OK: err == nil, LatencyMS: time.Since(start).Milliseconds()}
+			if err != nil {
+				result.Error = err.Error()
+			}
+
+			mu.Lock()
+			checks[c.Name()] = result
+			mu.Unlock()
+		}(c)
+	}
+
+	wg.Wait()
+
+	status := "ok"
+	for _, res := range checks {
+		if !res.OK {
+			status = "error"
+			break
+		}
+	}
+
+	return Result{Status: status, Checks: checks}
+}
@@ -0,0 +1,137 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeChecker is a Checker whose behavior is controlled by the test.
+type fakeChecker struct {
+	name  string
+	err   error
+	delay time.Duration
+}
+
+func (c fakeChecker) Name() string { return c.name }
+
+func (c fakeChecker) Check(ctx context.Context) error {
+	if c.delay == 0 {
+		return c.err
+	}
+
+	select {
+	case <-time.After(c.delay):
+		return c.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func TestRegistry_Run_AllOK(t *testing.T) {
+	r := NewRegistry()
+	r.Register(fakeChecker{name: "a"})
+	r.Register(fakeChecker{name: "b"})
+
+	result := r.Run(context.Background())
+
+	if result.Status != "ok" {
+		t.Fatalf("Status = %q, want %q", result.Status, "ok")
+	}
+	if len(result.Checks) != 2 {
+		t.Fatalf("len(Checks) = %d, want 2", len(result.Checks))
+	}
+	for name, check := range result.Checks {
+		if !check.OK {
+			t.Errorf("check %q: OK = false, want true", name)
+		}
+	}
+}
+
+func TestRegistry_Run_OneFails(t *testing.T) {
+	r := NewRegistry()
+	r.Register(fakeChecker{name: "a"})
+	r.Register(fakeChecker{name: "b", err: errors.New("boom")})
+
+	result := r.Run(context.Background())
+
+	if result.Status != "error" {
+		t.Fatalf("Status = %q, want %q", result.Status, "error")
+	}
+	if result.Checks["a"].OK != true {
+		t.Errorf("check a: OK = %v, want true", result.Checks["a"].OK)
+	}
+	if result.Checks["b"].OK != false {
+		t.Errorf("check b: OK = %v, want false", result.Checks["b"].OK)
+	}
+	if result.Checks["b"].Error != "boom" {
+		t.Errorf("check b: Error = %q, want %q", result.Checks["b"].Error, "boom")
+	}
+}
+
+func TestRegistry_Run_PerCheckTimeout(t *testing.T) {
+	r := NewRegistry()
+	r.Register(fakeChecker{name: "slow", delay: CheckTimeout * 2})
+
+	start := time.Now()
+	result := r.Run(context.Background())
+	elapsed := time.Since(start)
+
+	if result.Status != "error" {
+		t.Fatalf("Status = %q, want %q", result.Status, "error")
+	}
+	if elapsed >= CheckTimeout*2 {
+		t.Fatalf("Run took %s, want well under %s (per-check timeout should cut it off)", elapsed, CheckTimeout*2)
+	}
+}
+
+func TestRegistry_Run_Parallel(t *testing.T) {
+	const n = 10
+	const delay = 50 * time.Millisecond
+
+	r := NewRegistry()
+	var concurrent atomic.Int32
+	var maxConcurrent atomic.Int32
+
+	for i := 0; i < n; i++ {
+		r.Register(checkerFunc{
+			name: string(rune('a' + i)),
+			fn: func(ctx context.Context) error {
+				cur := concurrent.Add(1)
+				defer concurrent.Add(-1)
+
+				for {
+					prev := maxConcurrent.Load()
+					if cur <= prev || maxConcurrent.CompareAndSwap(prev, cur) {
+						break
+					}
+				}
+
+				time.Sleep(delay)
+				return nil
+			},
+		})
+	}
+
+	start := time.Now()
+	r.Run(context.Background())
+	elapsed := time.Since(start)
+
+	if elapsed >= delay*n {
+		t.Fatalf("Run took %s, want well under %s (checks should run in parallel)", elapsed, delay*n)
+	}
+	if maxConcurrent.Load() < 2 {
+		t.Fatalf("maxConcurrent = %d, want checks to have overlapped", maxConcurrent.Load())
+	}
+}
+
+// checkerFunc adapts a function to the Checker interface.
+type checkerFunc struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+func (c checkerFunc) Name() string                    { return c.name }
+func (c checkerFunc) Check(ctx context.Context) error { return c.fn(ctx) }
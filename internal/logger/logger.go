@@ -0,0 +1,40 @@
+// Package logger provides the shared application logger.
+package logger
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/superstas/pet-projects-droplet-stack/internal/config"
+)
+
+// New returns the process-wide structured logger, formatted and leveled per
+// cfg.Log.
+func New(cfg *config.Config) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: level(cfg.Log.Level)}
+
+	var handler slog.Handler
+	switch cfg.Log.Format {
+	case "json":
+		handler = slog.NewJSONHandler(os.Stdout, opts)
+	default:
+		handler = slog.NewTextHandler(os.Stdout, opts)
+	}
+
+	return slog.New(handler)
+}
+
+// level maps a config log level name to a slog.Level, defaulting to info for
+// unrecognized values.
+func level(name string) slog.Level {
+	switch name {
+	case "debug":
+		return slog.LevelDebug
+	case "warn":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
@@ -0,0 +1,8 @@
+package logger
+
+import "go.uber.org/fx"
+
+// Module wires the logger package into the fx application graph.
+var Module = fx.Module("logger",
+	fx.Provide(New),
+)
@@ -0,0 +1,25 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/fx"
+
+	"github.com/superstas/pet-projects-droplet-stack/internal/config"
+)
+
+// Module wires the metrics package into the fx application graph.
+var Module = fx.Module("metrics",
+	fx.Provide(New),
+	fx.Invoke(registerRoutes),
+)
+
+// registerRoutes mounts the Prometheus handler on mux, unless metrics are
+// disabled in config.
+func registerRoutes(mux *http.ServeMux, registry *prometheus.Registry, cfg *config.Config) {
+	if !cfg.Metrics.Enabled {
+		return
+	}
+	mux.Handle(cfg.Metrics.Path, Handler(registry))
+}
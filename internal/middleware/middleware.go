@@ -0,0 +1,93 @@
+// Package middleware provides HTTP middleware shared across handlers.
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/superstas/pet-projects-droplet-stack/internal/metrics"
+)
+
+// Func wraps an http.Handler with additional behavior.
+type Func func(http.Handler) http.Handler
+
+// Logging returns middleware that records RED (rate, errors, duration)
+// metrics for every request and logs its completion with correlated fields.
+// It attaches a request-scoped logger to the request context so downstream
+// handlers can log with the same fields via LoggerFromContext. The path
+// label is taken from the matched mux pattern rather than the raw URL so
+// cardinality stays bounded.
+func Logging(logger *slog.Logger, m *metrics.Metrics) Func {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			reqLogger := logger.With(
+				"request_id", newRequestID(),
+				"remote_addr", r.RemoteAddr,
+				"method", r.Method,
+				"path", r.URL.Path,
+			)
+			r = r.WithContext(withLogger(r.Context(), reqLogger))
+
+			// Resolve the mux pattern before dispatch so every metric,
+			// including the in-flight gauge, is labeled with it instead of
+			// the raw (attacker-controlled) URL path.
+			pattern := routePattern(next, r)
+
+			m.RequestsInFlight.WithLabelValues(r.Method, pattern).Inc()
+			defer m.RequestsInFlight.WithLabelValues(r.Method, pattern).Dec()
+
+			sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(sw, r)
+			duration := time.Since(start)
+
+			code := strconv.Itoa(sw.status)
+			m.RequestsTotal.WithLabelValues(r.Method, pattern, code).Inc()
+			m.RequestDuration.WithLabelValues(r.Method, pattern, code).Observe(duration.Seconds())
+
+			reqLogger.Info("request completed",
+				"proto", r.Proto,
+				"status", sw.status,
+				"size", sw.size,
+				"duration_ms", duration.Milliseconds(),
+			)
+		})
+	}
+}
+
+// routePattern resolves the registered mux pattern r will be dispatched to.
+// It falls back to the raw URL path if next isn't a *http.ServeMux.
+func routePattern(next http.Handler, r *http.Request) string {
+	mux, ok := next.(*http.ServeMux)
+	if !ok {
+		return r.URL.Path
+	}
+
+	if _, pattern := mux.Handler(r); pattern != "" {
+		return pattern
+	}
+
+	return r.URL.Path
+}
+
+// statusWriter wraps http.ResponseWriter to capture the status code and
+// response size written by the handler.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+	size   int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.size += n
+	return n, err
+}
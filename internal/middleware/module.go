@@ -0,0 +1,8 @@
+package middleware
+
+import "go.uber.org/fx"
+
+// Module wires the middleware package into the fx application graph.
+var Module = fx.Module("middleware",
+	fx.Provide(Logging),
+)
@@ -0,0 +1,14 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// newRequestID returns a short random identifier used to correlate the log
+// lines belonging to a single request.
+func newRequestID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/superstas/pet-projects-droplet-stack/internal/config"
+	"github.com/superstas/pet-projects-droplet-stack/internal/healthcheck"
+)
+
+// registerLifecycle hooks the HTTP server's start/stop into the fx
+// application lifecycle. When cfg.TLS configures a cert/key pair or an
+// autocert domain, it also runs a lightweight HTTP server on :80 that
+// redirects to HTTPS and answers ACME HTTP-01 challenges.
+func registerLifecycle(lc fx.Lifecycle, cfg *config.Config, srv *http.Server, hc *healthcheck.Healthcheck, logger *slog.Logger) {
+	var redirectSrv *http.Server
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			manager, tlsEnabled := configureTLS(cfg, srv)
+
+			ln, err := net.Listen("tcp", srv.Addr)
+			if err != nil {
+				return err
+			}
+
+			logger.Info("example application starting",
+				"addr", cfg.Addr(),
+				"tls", tlsEnabled,
+				"endpoints", []string{"/", "/livez", "/readyz", "/metrics"},
+			)
+
+			go func() {
+				var serveErr error
+				if tlsEnabled {
+					serveErr = srv.ServeTLS(ln, cfg.TLS.CertFile, cfg.TLS.KeyFile)
+				} else {
+					serveErr = srv.Serve(ln)
+				}
+				if serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
+					logger.Error("server exited unexpectedly", "error", serveErr)
+					os.Exit(1)
+				}
+			}()
+
+			if tlsEnabled {
+				redirectSrv = redirectServer(manager)
+				logger.Info("redirecting http to https", "addr", redirectSrv.Addr)
+
+				go func() {
+					if err := redirectSrv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+						logger.Error("redirect server exited unexpectedly", "error", err)
+					}
+				}()
+			}
+
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			logger.Info("received shutdown signal, draining in-flight requests",
+				"shutdown_timeout", cfg.HTTP.ShutdownTimeout,
+			)
+
+			// Mark not-ready first so load balancers stop routing new
+			// traffic to this instance before the drain deadline passes.
+			hc.SetNotReady()
+
+			// Deliberately not derived from ctx: fx bounds ctx by its own
+			// stop timeout, which would silently cap the configured drain
+			// deadline. cfg.HTTP.ShutdownTimeout is the one source of truth
+			// for how long the drain is allowed to run.
+			drainCtx, cancel := context.WithTimeout(context.Background(), cfg.HTTP.ShutdownTimeout)
+			defer cancel()
+
+			if redirectSrv != nil {
+				if err := redirectSrv.Shutdown(drainCtx); err != nil {
+					logger.Warn("error draining redirect server", "error", err)
+				}
+			}
+
+			start := time.Now()
+			err := srv.Shutdown(drainCtx)
+			drained := time.Since(start)
+
+			if err != nil {
+				logger.Warn("graceful drain timed out, forcing close",
+					"drain_duration", drained,
+					"error", err,
+				)
+				if closeErr := srv.Close(); closeErr != nil {
+					logger.Error("error forcing server close", "error", closeErr)
+					return closeErr
+				}
+			}
+
+			logger.Info("server stopped", "drain_duration", drained, "forced", err != nil)
+			return nil
+		},
+	})
+}
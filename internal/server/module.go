@@ -0,0 +1,14 @@
+package server
+
+import "go.uber.org/fx"
+
+// Module wires the server package into the fx application graph. Consumers
+// can extend the application by providing additional *http.ServeMux
+// registrations or fx.Lifecycle hooks alongside this module.
+var Module = fx.Module("server",
+	fx.Provide(
+		NewMux,
+		New,
+	),
+	fx.Invoke(registerLifecycle),
+)
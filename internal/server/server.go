@@ -0,0 +1,25 @@
+// Package server builds the HTTP server and manages its lifecycle.
+package server
+
+import (
+	"net/http"
+
+	"github.com/superstas/pet-projects-droplet-stack/internal/config"
+	"github.com/superstas/pet-projects-droplet-stack/internal/middleware"
+)
+
+// NewMux creates the root ServeMux that handler packages register routes on.
+func NewMux() *http.ServeMux {
+	return http.NewServeMux()
+}
+
+// New builds the HTTP server, wrapping mux with the logging middleware.
+func New(cfg *config.Config, mux *http.ServeMux, logging middleware.Func) *http.Server {
+	return &http.Server{
+		Addr:         cfg.Addr(),
+		Handler:      logging(mux),
+		ReadTimeout:  cfg.HTTP.ReadTimeout,
+		WriteTimeout: cfg.HTTP.WriteTimeout,
+		IdleTimeout:  cfg.HTTP.IdleTimeout,
+	}
+}
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+
+	"golang.org/x/crypto/acme/autocert"
+
+	"github.com/superstas/pet-projects-droplet-stack/internal/config"
+)
+
+// hstsHeader is advertised on every response once TLS is active, per
+// Mozilla's "modern" TLS configuration guidance.
+const hstsHeader = "max-age=63072000; includeSubDomains"
+
+// modernCipherSuites restricts TLS 1.2 negotiation to suites recommended by
+// Mozilla's "modern" configuration. TLS 1.3 ignores this list and always
+// negotiates its own AEAD suites.
+var modernCipherSuites = []uint16{
+	tls.TLS_AES_128_GCM_SHA256,
+	tls.TLS_AES_256_GCM_SHA384,
+	tls.TLS_CHACHA20_POLY1305_SHA256,
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+}
+
+// configureTLS applies cfg.TLS to srv. It returns ok=false when neither a
+// cert/key pair nor an autocert domain is configured, in which case the
+// caller should serve plain HTTP. manager is non-nil only for the autocert
+// path, where the redirect server also needs it to answer ACME HTTP-01
+// challenges.
+func configureTLS(cfg *config.Config, srv *http.Server) (manager *autocert.Manager, ok bool) {
+	switch {
+	case cfg.TLS.AutoCert != "":
+		manager = &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(cfg.TLS.AutoCert),
+			Cache:      autocert.DirCache("autocert-cache"),
+		}
+		srv.TLSConfig = manager.TLSConfig()
+	case cfg.TLS.CertFile != "" && cfg.TLS.KeyFile != "":
+		srv.TLSConfig = &tls.Config{
+			MinVersion:   tls.VersionTLS12,
+			CipherSuites: modernCipherSuites,
+		}
+	default:
+		return nil, false
+	}
+
+	srv.Handler = hsts(srv.Handler)
+	return manager, true
+}
+
+// hsts wraps next with middleware that advertises Strict-Transport-Security.
+func hsts(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Strict-Transport-Security", hstsHeader)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// redirectServer builds the lightweight HTTP server that runs alongside a
+// TLS-enabled srv: it redirects plain HTTP traffic to HTTPS and, when
+// manager is non-nil, answers ACME HTTP-01 challenges for it.
+func redirectServer(manager *autocert.Manager) *http.Server {
+	var handler http.Handler = http.HandlerFunc(redirectToHTTPS)
+	if manager != nil {
+		handler = manager.HTTPHandler(handler)
+	}
+
+	return &http.Server{
+		Addr:    ":80",
+		Handler: handler,
+	}
+}
+
+func redirectToHTTPS(w http.ResponseWriter, r *http.Request) {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusMovedPermanently)
+}